@@ -0,0 +1,178 @@
+package core
+
+import (
+	"context"
+	"sync"
+
+	"github.com/null-bd/logger/types"
+)
+
+const defaultAsyncBufferSize = 1024
+
+// asyncItem is what actually travels over asyncWriter.queue. entry is set
+// for a real log event; done is set instead for a flush marker, which run
+// closes once it reaches the front of the queue rather than writing it.
+// Because run processes the queue strictly in order, a closed done means
+// every entry enqueued before the marker has already been written.
+type asyncItem struct {
+	entry *logEntry
+	done  chan struct{}
+}
+
+// asyncWriter decouples Debug/Info/... from the underlying io.Writer(s) by
+// enqueuing entries onto a bounded channel drained by a background
+// goroutine. When the buffer is full it applies Config.Async.OverflowPolicy.
+type asyncWriter struct {
+	queue   chan asyncItem
+	policy  types.OverflowPolicy
+	writeFn func(*logEntry)
+	wg      sync.WaitGroup
+
+	// closeMu guards the queue's open/closed state: enqueue holds it for
+	// read so several producers can send concurrently, and close takes it
+	// for write so it never closes the channel out from under a send.
+	closeMu sync.RWMutex
+	closed  bool
+
+	mu      sync.Mutex
+	dropped map[types.Level]uint64
+}
+
+func newAsyncWriter(cfg *types.AsyncConfig, writeFn func(*logEntry)) *asyncWriter {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	size := cfg.BufferSize
+	if size <= 0 {
+		size = defaultAsyncBufferSize
+	}
+
+	a := &asyncWriter{
+		queue:   make(chan asyncItem, size),
+		policy:  cfg.OverflowPolicy,
+		writeFn: writeFn,
+		dropped: make(map[types.Level]uint64),
+	}
+
+	a.wg.Add(1)
+	go a.run()
+
+	return a
+}
+
+func (a *asyncWriter) run() {
+	defer a.wg.Done()
+	for item := range a.queue {
+		if item.done != nil {
+			close(item.done)
+			continue
+		}
+		a.writeFn(item.entry)
+	}
+}
+
+// enqueue never blocks the caller unless OverflowPolicy is "block". It holds
+// closeMu for read for its whole body, including a blocking send under
+// OverflowBlock, so close (which takes closeMu for write) can never close the
+// queue while a send to it is in flight.
+func (a *asyncWriter) enqueue(entry *logEntry) {
+	a.closeMu.RLock()
+	defer a.closeMu.RUnlock()
+
+	if a.closed {
+		a.recordDrop(entry.Level)
+		return
+	}
+
+	item := asyncItem{entry: entry}
+
+	select {
+	case a.queue <- item:
+		return
+	default:
+	}
+
+	switch a.policy {
+	case types.OverflowBlock:
+		a.queue <- item
+	case types.OverflowDropOldest:
+		select {
+		case <-a.queue:
+		default:
+		}
+		select {
+		case a.queue <- item:
+		default:
+			a.recordDrop(entry.Level)
+		}
+	default: // OverflowDropNewest, and the zero value, drop the incoming entry
+		a.recordDrop(entry.Level)
+	}
+}
+
+func (a *asyncWriter) recordDrop(level types.Level) {
+	a.mu.Lock()
+	a.dropped[level]++
+	a.mu.Unlock()
+}
+
+func (a *asyncWriter) droppedByLevel() map[types.Level]uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	out := make(map[types.Level]uint64, len(a.dropped))
+	for level, count := range a.dropped {
+		out[level] = count
+	}
+	return out
+}
+
+func (a *asyncWriter) depth() int {
+	return len(a.queue)
+}
+
+// flush blocks until every entry enqueued before this call has actually been
+// written, or ctx is done. It works by enqueuing a marker item behind them
+// and waiting for run to reach and close it; since run drains the queue
+// strictly in order, that can't happen until every prior entry's writeFn
+// call has returned.
+func (a *asyncWriter) flush(ctx context.Context) error {
+	a.closeMu.RLock()
+	if a.closed {
+		a.closeMu.RUnlock()
+		return nil
+	}
+
+	done := make(chan struct{})
+	select {
+	case a.queue <- asyncItem{done: done}:
+	case <-ctx.Done():
+		a.closeMu.RUnlock()
+		return ctx.Err()
+	}
+	a.closeMu.RUnlock()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close drains the queue and stops the background goroutine. It is safe to
+// call concurrently with enqueue, and idempotent.
+func (a *asyncWriter) close() error {
+	a.closeMu.Lock()
+	if a.closed {
+		a.closeMu.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.queue)
+	a.closeMu.Unlock()
+
+	a.wg.Wait()
+	return nil
+}
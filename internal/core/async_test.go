@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+func TestAsyncWriterOverflowPolicies(t *testing.T) {
+	t.Run("drop_newest drops the incoming entry once full", func(t *testing.T) {
+		block := make(chan struct{})
+		a := newAsyncWriter(&types.AsyncConfig{
+			Enabled:        true,
+			BufferSize:     1,
+			OverflowPolicy: types.OverflowDropNewest,
+		}, func(e *logEntry) { <-block })
+		defer func() {
+			close(block)
+			a.close()
+		}()
+
+		a.enqueue(&logEntry{Level: types.InfoLevel}) // picked up by run(), blocks on <-block
+		time.Sleep(10 * time.Millisecond)             // let run() start consuming it
+		a.enqueue(&logEntry{Level: types.WarnLevel})  // fills the now-empty buffer
+		a.enqueue(&logEntry{Level: types.ErrorLevel}) // buffer full -> dropped
+
+		if dropped := a.droppedByLevel(); dropped[types.ErrorLevel] != 1 {
+			t.Fatalf("expected 1 dropped error entry, got %v", dropped)
+		}
+	})
+
+	t.Run("drop_oldest evicts the head to make room", func(t *testing.T) {
+		block := make(chan struct{})
+		a := newAsyncWriter(&types.AsyncConfig{
+			Enabled:        true,
+			BufferSize:     1,
+			OverflowPolicy: types.OverflowDropOldest,
+		}, func(e *logEntry) { <-block })
+		defer func() {
+			close(block)
+			a.close()
+		}()
+
+		a.enqueue(&logEntry{Level: types.InfoLevel}) // picked up by run(), blocks on <-block
+		time.Sleep(10 * time.Millisecond)
+		a.enqueue(&logEntry{Level: types.WarnLevel})  // fills the buffer
+		a.enqueue(&logEntry{Level: types.ErrorLevel}) // evicts WarnLevel, takes its slot
+
+		if depth := a.depth(); depth != 1 {
+			t.Fatalf("expected queue depth 1, got %d", depth)
+		}
+		if dropped := a.droppedByLevel(); len(dropped) != 0 {
+			t.Fatalf("drop_oldest should not count as a drop, got %v", dropped)
+		}
+	})
+}
+
+func TestAsyncWriterFlushWaitsForWrite(t *testing.T) {
+	var wrote int32
+	a := newAsyncWriter(&types.AsyncConfig{Enabled: true, BufferSize: 4}, func(e *logEntry) {
+		time.Sleep(50 * time.Millisecond)
+		atomic.StoreInt32(&wrote, 1)
+	})
+	defer a.close()
+
+	a.enqueue(&logEntry{Level: types.InfoLevel})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := a.flush(ctx); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	if atomic.LoadInt32(&wrote) != 1 {
+		t.Fatal("flush returned before the queued write had completed")
+	}
+}
+
+func TestAsyncWriterCloseDuringConcurrentEnqueue(t *testing.T) {
+	a := newAsyncWriter(&types.AsyncConfig{Enabled: true, BufferSize: 16}, func(e *logEntry) {})
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				a.enqueue(&logEntry{Level: types.InfoLevel})
+			}
+		}
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	if err := a.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	close(stop)
+	wg.Wait()
+}
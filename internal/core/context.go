@@ -1,4 +1,12 @@
 // trace_context.go
+//
+// Deprecated: this goroutine-ID trace store is superseded by WithFields and
+// FieldsFromContext in ctx_fields.go, which propagate fields via
+// context.Context instead of parsing runtime.Stack for a goroutine ID. That
+// parsing silently loses fields across goroutine boundaries (spawned
+// workers don't share their parent's ID) and requires a background janitor
+// to bound memory. The functions below are kept as a thin shim so existing
+// callers keep working while they migrate to WithFields/FieldsFromContext.
 package core
 
 import (
@@ -109,7 +117,10 @@ func performSizeBasedCleanup() {
 	}
 }
 
-// SetTraceFields sets trace fields for current goroutine
+// SetTraceFields sets trace fields for current goroutine.
+//
+// Deprecated: use WithFields and pass the resulting context.Context through
+// your call chain instead.
 func SetTraceFields(fields types.Fields) {
 	gID := getGoroutineID()
 	globalTraceContext.mu.Lock()
@@ -120,7 +131,9 @@ func SetTraceFields(fields types.Fields) {
 	globalTraceContext.mu.Unlock()
 }
 
-// GetTraceFields gets trace fields for current goroutine
+// GetTraceFields gets trace fields for current goroutine.
+//
+// Deprecated: use FieldsFromContext instead.
 func GetTraceFields() types.Fields {
 	gID := getGoroutineID()
 	globalTraceContext.mu.RLock()
@@ -132,7 +145,10 @@ func GetTraceFields() types.Fields {
 	return nil
 }
 
-// ClearTraceFields removes trace fields for current goroutine
+// ClearTraceFields removes trace fields for current goroutine.
+//
+// Deprecated: context.Context values are scoped by the caller, so there is
+// nothing to clear when using WithFields/FieldsFromContext.
 func ClearTraceFields() {
 	gID := getGoroutineID()
 	globalTraceContext.mu.Lock()
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"context"
+
+	"github.com/null-bd/logger/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ctxFieldsKey struct{}
+
+// WithFields returns a context carrying fields, merged on top of any fields
+// already attached to ctx by a previous call. Logger.Debug/Info/... read
+// these back out via FieldsFromContext, so they enrich every log call made
+// with the returned context without the caller threading Fields explicitly.
+func WithFields(ctx context.Context, fields types.Fields) context.Context {
+	merged := make(types.Fields, len(fields))
+	for k, v := range FieldsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, ctxFieldsKey{}, merged)
+}
+
+// FieldsFromContext returns the fields attached to ctx by WithFields, or nil
+// if none have been attached.
+func FieldsFromContext(ctx context.Context) types.Fields {
+	fields, _ := ctx.Value(ctxFieldsKey{}).(types.Fields)
+	return fields
+}
+
+// otelTraceFields returns trace_id/span_id derived from ctx's OpenTelemetry
+// span, if one is present and valid.
+func otelTraceFields(ctx context.Context) (traceID, spanID string, ok bool) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return "", "", false
+	}
+	return sc.TraceID().String(), sc.SpanID().String(), true
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/null-bd/logger/types"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestFieldsFromContextEmptyByDefault(t *testing.T) {
+	if fields := FieldsFromContext(context.Background()); fields != nil {
+		t.Fatalf("expected no fields on a bare context, got %v", fields)
+	}
+}
+
+func TestWithFieldsMergesOnTopOfPriorCalls(t *testing.T) {
+	ctx := context.Background()
+	ctx = WithFields(ctx, types.Fields{"request_id": "r1", "a": 1})
+	ctx = WithFields(ctx, types.Fields{"a": 2, "b": 3})
+
+	got := FieldsFromContext(ctx)
+	want := types.Fields{"request_id": "r1", "a": 2, "b": 3}
+
+	if len(got) != len(want) {
+		t.Fatalf("expected %d fields, got %d (%v)", len(want), len(got), got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("field %q: expected %v, got %v", k, v, got[k])
+		}
+	}
+}
+
+func TestOtelTraceFieldsWithoutSpan(t *testing.T) {
+	if _, _, ok := otelTraceFields(context.Background()); ok {
+		t.Fatal("expected no trace fields on a context without a span")
+	}
+}
+
+func TestOtelTraceFieldsFromValidSpanContext(t *testing.T) {
+	traceID, err := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	if err != nil {
+		t.Fatalf("TraceIDFromHex: %v", err)
+	}
+	spanID, err := trace.SpanIDFromHex("0102030405060708")
+	if err != nil {
+		t.Fatalf("SpanIDFromHex: %v", err)
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	gotTraceID, gotSpanID, ok := otelTraceFields(ctx)
+	if !ok {
+		t.Fatal("expected a valid span context to report ok")
+	}
+	if gotTraceID != traceID.String() {
+		t.Errorf("expected trace ID %q, got %q", traceID.String(), gotTraceID)
+	}
+	if gotSpanID != spanID.String() {
+		t.Errorf("expected span ID %q, got %q", spanID.String(), gotSpanID)
+	}
+}
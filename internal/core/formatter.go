@@ -0,0 +1,231 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+// FormatterFunc adapts a plain function into a types.Formatter.
+type FormatterFunc func(entry *types.Entry) ([]byte, error)
+
+func (f FormatterFunc) Format(entry *types.Entry) ([]byte, error) { return f(entry) }
+
+var formatters = map[string]types.Formatter{
+	"json":       FormatterFunc(formatJSON),
+	"text":       FormatterFunc(formatText),
+	"logfmt":     FormatterFunc(formatLogfmt),
+	"console":    FormatterFunc(formatConsole),
+	"cee-syslog": FormatterFunc(formatCEESyslog),
+	"otlp-json":  FormatterFunc(formatOTLPJSON),
+}
+
+// RegisterFormatter registers f under name, so Config.Format or
+// SinkConfig.Format values of name encode through it. Registering under an
+// existing name (including a built-in one) replaces it.
+func RegisterFormatter(name string, f types.Formatter) {
+	formatters[name] = f
+}
+
+// encodeEntry renders entry with the Formatter registered for format,
+// falling back to "text" when format is unset or unrecognized.
+func encodeEntry(entry *logEntry, format string) ([]byte, error) {
+	f, ok := formatters[format]
+	if !ok {
+		f = formatters["text"]
+	}
+	return f.Format(entry)
+}
+
+func formatJSON(entry *types.Entry) ([]byte, error) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func formatText(entry *types.Entry) ([]byte, error) {
+	return []byte(fmt.Sprintf("[%s] %s: %s (RequestID: %s)\n",
+		entry.Timestamp.Format(time.RFC3339),
+		entry.Level,
+		entry.Message,
+		entry.RequestID)), nil
+}
+
+// sortedFieldKeys returns fields' keys in a stable order, so formatters that
+// aren't inherently ordered (logfmt, console, otlp-json) produce
+// deterministic output.
+func sortedFieldKeys(fields types.Fields) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatLogfmt renders entry as logfmt (key=value, quoted where needed), the
+// encoding used by tools like Heroku's log router and InfluxDB line protocol
+// predecessors.
+func formatLogfmt(entry *types.Entry) ([]byte, error) {
+	var b strings.Builder
+
+	writeLogfmtPair(&b, "timestamp", entry.Timestamp.Format(time.RFC3339Nano))
+	writeLogfmtPair(&b, "level", string(entry.Level))
+	writeLogfmtPair(&b, "service", entry.Service)
+	writeLogfmtPair(&b, "environment", entry.Environment)
+	if entry.RequestID != "" {
+		writeLogfmtPair(&b, "request_id", entry.RequestID)
+	}
+	if entry.TraceID != "" {
+		writeLogfmtPair(&b, "trace_id", entry.TraceID)
+	}
+	if entry.SpanID != "" {
+		writeLogfmtPair(&b, "span_id", entry.SpanID)
+	}
+	writeLogfmtPair(&b, "message", entry.Message)
+
+	for _, k := range sortedFieldKeys(entry.Fields) {
+		writeLogfmtPair(&b, k, fmt.Sprintf("%v", entry.Fields[k]))
+	}
+
+	b.WriteByte('\n')
+	return []byte(b.String()), nil
+}
+
+func writeLogfmtPair(b *strings.Builder, key, value string) {
+	if b.Len() > 0 {
+		b.WriteByte(' ')
+	}
+	b.WriteString(key)
+	b.WriteByte('=')
+	if needsLogfmtQuoting(value) {
+		b.WriteString(strconv.Quote(value))
+	} else {
+		b.WriteString(value)
+	}
+}
+
+func needsLogfmtQuoting(s string) bool {
+	if s == "" {
+		return true
+	}
+	for _, r := range s {
+		if r <= ' ' || r == '"' || r == '=' {
+			return true
+		}
+	}
+	return false
+}
+
+// consoleLevelColors mirror the palette of terminal log handlers like
+// go-ethereum's: cyan for debug, green for info, yellow for warn, red for
+// error, and magenta for fatal.
+var consoleLevelColors = map[types.Level]string{
+	types.DebugLevel: "\x1b[36m",
+	types.InfoLevel:  "\x1b[32m",
+	types.WarnLevel:  "\x1b[33m",
+	types.ErrorLevel: "\x1b[31m",
+	types.FatalLevel: "\x1b[35m",
+}
+
+const consoleColorReset = "\x1b[0m"
+
+// formatConsole renders entry as a colorized, human-readable line for local
+// development, in the spirit of go-ethereum's terminal handler.
+func formatConsole(entry *types.Entry) ([]byte, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s[%-5s]%s %s %s",
+		consoleLevelColors[entry.Level],
+		strings.ToUpper(string(entry.Level)),
+		consoleColorReset,
+		entry.Timestamp.Format("15:04:05.000"),
+		entry.Message)
+
+	for _, k := range sortedFieldKeys(entry.Fields) {
+		fmt.Fprintf(&b, " %s=%v", k, entry.Fields[k])
+	}
+
+	b.WriteByte('\n')
+	return []byte(b.String()), nil
+}
+
+// formatCEESyslog renders entry as "@cee:"-prefixed JSON, the convention
+// rsyslog's mmjsonparse module looks for to parse a message as structured
+// data instead of free text.
+func formatCEESyslog(entry *types.Entry) ([]byte, error) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len("@cee:")+len(b)+1)
+	out = append(out, "@cee:"...)
+	out = append(out, b...)
+	out = append(out, '\n')
+	return out, nil
+}
+
+// otlpSeverityNumbers maps our levels onto the OpenTelemetry log data
+// model's 1-24 severity number range (SeverityNumberDebug/Info/Warn/Error).
+var otlpSeverityNumbers = map[types.Level]int{
+	types.DebugLevel: 5,
+	types.InfoLevel:  9,
+	types.WarnLevel:  13,
+	types.ErrorLevel: 17,
+	types.FatalLevel: 21,
+}
+
+type otlpAttribute struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           string          `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes,omitempty"`
+	TraceID        string          `json:"traceId,omitempty"`
+	SpanID         string          `json:"spanId,omitempty"`
+}
+
+// formatOTLPJSON renders entry matching the OpenTelemetry log data model, so
+// collectors that already speak OTLP/JSON can ingest it directly.
+func formatOTLPJSON(entry *types.Entry) ([]byte, error) {
+	attrs := []otlpAttribute{
+		{Key: "service", Value: entry.Service},
+		{Key: "environment", Value: entry.Environment},
+	}
+	if entry.RequestID != "" {
+		attrs = append(attrs, otlpAttribute{Key: "request_id", Value: entry.RequestID})
+	}
+
+	for _, k := range sortedFieldKeys(entry.Fields) {
+		attrs = append(attrs, otlpAttribute{Key: k, Value: entry.Fields[k]})
+	}
+
+	record := otlpLogRecord{
+		TimeUnixNano:   strconv.FormatInt(entry.Timestamp.UnixNano(), 10),
+		SeverityNumber: otlpSeverityNumbers[entry.Level],
+		SeverityText:   strings.ToUpper(string(entry.Level)),
+		Body:           entry.Message,
+		Attributes:     attrs,
+		TraceID:        entry.TraceID,
+		SpanID:         entry.SpanID,
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
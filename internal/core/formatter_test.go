@@ -0,0 +1,120 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+func testEntry() *types.Entry {
+	return &types.Entry{
+		Timestamp:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Level:       types.InfoLevel,
+		Message:     "hello world",
+		Service:     "svc",
+		Environment: "prod",
+		RequestID:   "req-1",
+		TraceID:     "trace-1",
+		SpanID:      "span-1",
+		Fields:      types.Fields{"count": 3},
+	}
+}
+
+func TestFormatLogfmt(t *testing.T) {
+	b, err := formatLogfmt(testEntry())
+	if err != nil {
+		t.Fatalf("formatLogfmt: %v", err)
+	}
+	line := string(b)
+
+	for _, want := range []string{
+		`level=info`, `service=svc`, `environment=prod`,
+		`request_id=req-1`, `trace_id=trace-1`, `span_id=span-1`,
+		`message="hello world"`, `count=3`,
+	} {
+		if !strings.Contains(line, want) {
+			t.Errorf("expected logfmt output to contain %q, got %q", want, line)
+		}
+	}
+}
+
+func TestFormatConsoleIncludesFieldsAndColor(t *testing.T) {
+	b, err := formatConsole(testEntry())
+	if err != nil {
+		t.Fatalf("formatConsole: %v", err)
+	}
+	line := string(b)
+
+	if !strings.Contains(line, "INFO") {
+		t.Errorf("expected level to be uppercased, got %q", line)
+	}
+	if !strings.Contains(line, "hello world") {
+		t.Errorf("expected the message in output, got %q", line)
+	}
+	if !strings.Contains(line, "count=3") {
+		t.Errorf("expected fields in output, got %q", line)
+	}
+}
+
+func TestFormatCEESyslogPrefixesValidJSON(t *testing.T) {
+	b, err := formatCEESyslog(testEntry())
+	if err != nil {
+		t.Fatalf("formatCEESyslog: %v", err)
+	}
+	if !strings.HasPrefix(string(b), "@cee:") {
+		t.Fatalf("expected @cee: prefix, got %q", b)
+	}
+
+	var decoded types.Entry
+	if err := json.Unmarshal(b[len("@cee:"):], &decoded); err != nil {
+		t.Fatalf("expected valid JSON after the @cee: prefix: %v", err)
+	}
+	if decoded.Message != "hello world" {
+		t.Errorf("expected message to round-trip, got %q", decoded.Message)
+	}
+}
+
+func TestFormatOTLPJSONUsesEntrySpanID(t *testing.T) {
+	b, err := formatOTLPJSON(testEntry())
+	if err != nil {
+		t.Fatalf("formatOTLPJSON: %v", err)
+	}
+
+	var record otlpLogRecord
+	if err := json.Unmarshal(b, &record); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	if record.TraceID != "trace-1" {
+		t.Errorf("expected traceId trace-1, got %q", record.TraceID)
+	}
+	if record.SpanID != "span-1" {
+		t.Errorf("expected spanId span-1, got %q", record.SpanID)
+	}
+	if record.SeverityText != "INFO" {
+		t.Errorf("expected severityText INFO, got %q", record.SeverityText)
+	}
+	if record.SeverityNumber != otlpSeverityNumbers[types.InfoLevel] {
+		t.Errorf("expected severityNumber %d, got %d", otlpSeverityNumbers[types.InfoLevel], record.SeverityNumber)
+	}
+
+	for _, attr := range record.Attributes {
+		if attr.Key == "span_id" {
+			t.Fatalf("span_id should no longer appear as a generic attribute, found %v", attr)
+		}
+	}
+}
+
+func TestEncodeEntryFallsBackToText(t *testing.T) {
+	b, err := encodeEntry(testEntry(), "not-a-registered-format")
+	if err != nil {
+		t.Fatalf("encodeEntry: %v", err)
+	}
+	want, _ := formatText(testEntry())
+	if string(b) != string(want) {
+		t.Errorf("expected unrecognized format to fall back to text, got %q want %q", b, want)
+	}
+}
@@ -1,32 +1,70 @@
 package core
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/null-bd/logger/types"
 )
 
-type logEntry struct {
-	Timestamp   time.Time    `json:"timestamp"`
-	Level       types.Level  `json:"level"`
-	Message     string       `json:"message"`
-	Service     string       `json:"service"`
-	Environment string       `json:"environment"`
-	RequestID   string       `json:"request_id,omitempty"`
-	TraceID     string       `json:"trace_id,omitempty"`
-	Fields      types.Fields `json:"fields,omitempty"`
-}
+// logEntry is an alias for types.Entry so the rest of this package can keep
+// using the shorter name; Formatter implementations (including user-
+// registered ones) operate on the same type via types.Entry.
+type logEntry = types.Entry
 
 type Logger struct {
 	mu            sync.RWMutex
 	config        *types.Config
 	writers       []io.Writer
+	closers       []io.Closer
 	defaultFields types.Fields
+	slogHandler   slog.Handler
+	sampler       *sampler
+	limiter       *rateLimiter
+	contexters    []types.Contexter
+	async         *asyncWriter
+	flushLatency  int64 // nanoseconds, accessed atomically
+	sinks         []*sinkPipeline
+	// minLevel is the pre-dispatch level gate checked by isLevelEnabled; see
+	// initializeSinks for how it relates to Config.LogLevel.
+	minLevel types.Level
+}
+
+// sinkPipeline pairs a Sink with the per-sink settings from its SinkConfig:
+// a level floor, its own encoding, and its own optional async buffer.
+type sinkPipeline struct {
+	sink     Sink
+	minLevel types.Level
+	format   string
+	async    *asyncWriter
+}
+
+func (p *sinkPipeline) enabled(level types.Level) bool {
+	return levelAtLeast(level, p.minLevel)
+}
+
+func (p *sinkPipeline) dispatch(entry *logEntry) {
+	if p.async != nil {
+		p.async.enqueue(entry)
+		return
+	}
+	p.write(entry)
+}
+
+func (p *sinkPipeline) write(entry *logEntry) {
+	payload, err := encodeEntry(entry, p.format)
+	if err != nil {
+		return
+	}
+	if err := p.sink.Write(entry, payload); err != nil {
+		fmt.Fprintf(os.Stderr, "logger: sink write error: %v\n", err)
+	}
 }
 
 func NewLogger(cfg *types.Config) (types.Logger, error) {
@@ -38,10 +76,20 @@ func NewLogger(cfg *types.Config) (types.Logger, error) {
 		config:        cfg,
 		defaultFields: make(types.Fields),
 		writers:       make([]io.Writer, 0),
+		sampler:       newSampler(cfg.Sampling),
+		limiter:       newRateLimiter(cfg.RateLimit),
+		minLevel:      cfg.LogLevel,
 	}
 
-	if err := l.initializeWriters(); err != nil {
-		return nil, err
+	if len(cfg.Sinks) > 0 {
+		if err := l.initializeSinks(); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := l.initializeWriters(); err != nil {
+			return nil, err
+		}
+		l.async = newAsyncWriter(cfg.Async, l.writeLogSync)
 	}
 
 	for k, v := range cfg.DefaultFields {
@@ -58,10 +106,83 @@ func (l *Logger) initializeWriters() error {
 			return fmt.Errorf("failed to create writer for %s: %v", path, err)
 		}
 		l.writers = append(l.writers, writer)
+
+		// stdout/stderr are shared with the rest of the process and must
+		// outlive this Logger, so only opened files are tracked for Close.
+		if path != "stdout" && path != "stderr" {
+			if closer, ok := writer.(io.Closer); ok {
+				l.closers = append(l.closers, closer)
+			}
+		}
+	}
+
+	l.slogHandler = l.newSlogHandler()
+
+	return nil
+}
+
+// initializeSinks builds the Config.Sinks pipeline. It is the preferred
+// alternative to initializeWriters/OutputPaths, since it gives each output
+// its own level floor, encoding, and async buffering.
+//
+// l.minLevel (the pre-sink-dispatch gate in log/isLevelEnabled) is lowered to
+// the most permissive of Config.LogLevel and every sink's MinLevel, so a
+// sink configured with a lower floor than Config.LogLevel still sees the
+// levels it asked for; sinkPipeline.enabled applies each sink's own MinLevel
+// on top to keep the other sinks no more permissive than they asked for.
+func (l *Logger) initializeSinks() error {
+	for _, sc := range l.config.Sinks {
+		format := sc.Format
+		if format == "" {
+			format = l.config.Format
+		}
+		if format == "slog-json" || format == "slog-text" {
+			return fmt.Errorf("logger: sink %q: format %q requires Config.OutputPaths, not Config.Sinks", sc.Type, format)
+		}
+
+		sink, err := buildSink(sc)
+		if err != nil {
+			return fmt.Errorf("failed to create sink %q: %w", sc.Type, err)
+		}
+
+		p := &sinkPipeline{sink: sink, minLevel: sc.MinLevel, format: format}
+		p.async = newAsyncWriter(sc.Async, p.write)
+		l.sinks = append(l.sinks, p)
+
+		if levelRank(sc.MinLevel) < levelRank(l.minLevel) {
+			l.minLevel = sc.MinLevel
+		}
 	}
 	return nil
 }
 
+// newSlogHandler builds a log/slog handler over the configured writers when
+// Config.Format selects a slog-native encoding ("slog-json" or "slog-text").
+// It returns nil for every other format, leaving writeLogSync to encode
+// through the Formatter registered for Config.Format (see encodeEntry).
+func (l *Logger) newSlogHandler() slog.Handler {
+	if len(l.writers) == 0 {
+		return nil
+	}
+
+	var out io.Writer
+	if len(l.writers) == 1 {
+		out = l.writers[0]
+	} else {
+		out = io.MultiWriter(l.writers...)
+	}
+
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	switch l.config.Format {
+	case "slog-json":
+		return slog.NewJSONHandler(out, opts)
+	case "slog-text":
+		return slog.NewTextHandler(out, opts)
+	default:
+		return nil
+	}
+}
+
 func (l *Logger) createWriter(path string) (io.Writer, error) {
 	switch path {
 	case "stdout":
@@ -73,10 +194,24 @@ func (l *Logger) createWriter(path string) (io.Writer, error) {
 	}
 }
 
-func (l *Logger) log(level types.Level, msg string, fields types.Fields) {
+func (l *Logger) log(ctx context.Context, level types.Level, msg string, fields types.Fields) {
+	// Level, sampling, and rate-limit checks run before any allocation so
+	// filtered-out events cost a handful of map lookups, not a timestamp
+	// format, a Fields map, or a JSON marshal.
 	if !l.isLevelEnabled(level) {
 		return
 	}
+	// Fatal bypasses sampling and rate-limiting: os.Exit follows immediately
+	// after, and a Fatal call silently swallowed by either would kill the
+	// process with no trace of why.
+	if level != types.FatalLevel {
+		if !l.sampler.allow(level) {
+			return
+		}
+		if !l.limiter.allow(level) {
+			return
+		}
+	}
 
 	entry := &logEntry{
 		Timestamp:   time.Now().UTC(),
@@ -87,6 +222,7 @@ func (l *Logger) log(level types.Level, msg string, fields types.Fields) {
 		Fields:      make(types.Fields),
 	}
 
+	// Deprecated goroutine-local trace store; prefer WithFields(ctx, ...).
 	for k, v := range GetTraceFields() {
 		switch k {
 		case "request_id":
@@ -98,22 +234,65 @@ func (l *Logger) log(level types.Level, msg string, fields types.Fields) {
 		}
 	}
 
-	l.mergeFields(entry, fields)
+	l.mergeFields(ctx, entry, fields)
+
+	if traceID, spanID, ok := otelTraceFields(ctx); ok {
+		entry.TraceID = traceID
+		entry.SpanID = spanID
+	}
+
+	l.applyContexters(ctx, entry)
 	l.writeLog(entry)
 }
 
+// applyContexters merges fields derived from each registered Contexter into
+// entry. It runs only once an event has passed every filter above, so
+// per-request enrichers are never paid for on a log call that gets dropped.
+func (l *Logger) applyContexters(ctx context.Context, entry *logEntry) {
+	if len(l.contexters) == 0 {
+		return
+	}
+
+	for _, fn := range l.contexters {
+		for k, v := range fn(ctx) {
+			entry.Fields[k] = v
+		}
+	}
+}
+
+var levelRanks = map[types.Level]int{
+	types.DebugLevel: 0,
+	types.InfoLevel:  1,
+	types.WarnLevel:  2,
+	types.ErrorLevel: 3,
+	types.FatalLevel: 4,
+}
+
+// isLevelEnabled checks level against l.minLevel: Config.LogLevel when there
+// are no Config.Sinks, or the most permissive of Config.LogLevel and every
+// sink's MinLevel when there are (see initializeSinks) so this pre-dispatch
+// gate can't reject a level some sink still wants.
 func (l *Logger) isLevelEnabled(level types.Level) bool {
-	levels := map[types.Level]int{
-		types.DebugLevel: 0,
-		types.InfoLevel:  1,
-		types.WarnLevel:  2,
-		types.ErrorLevel: 3,
-		types.FatalLevel: 4,
+	return levelAtLeast(level, l.minLevel)
+}
+
+// levelRank returns level's numeric rank, or -1 for an empty Level (e.g. an
+// unset SinkConfig.MinLevel), so an empty level always reads as "lower" than
+// any concrete one.
+func levelRank(level types.Level) int {
+	if level == "" {
+		return -1
 	}
-	return levels[level] >= levels[l.config.LogLevel]
+	return levelRanks[level]
+}
+
+// levelAtLeast reports whether level is at or above min. An empty min (e.g.
+// an unset SinkConfig.MinLevel) allows everything.
+func levelAtLeast(level, min types.Level) bool {
+	return levelRanks[level] >= levelRank(min)
 }
 
-func (l *Logger) mergeFields(entry *logEntry, fields types.Fields) {
+func (l *Logger) mergeFields(ctx context.Context, entry *logEntry, fields types.Fields) {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
 
@@ -121,67 +300,200 @@ func (l *Logger) mergeFields(entry *logEntry, fields types.Fields) {
 		entry.Fields[k] = v
 	}
 
+	for k, v := range FieldsFromContext(ctx) {
+		entry.Fields[k] = v
+	}
+
 	for k, v := range fields {
 		entry.Fields[k] = v
 	}
 }
 
+// writeLog routes entry to the Config.Sinks pipeline when one is configured;
+// otherwise it falls back to the legacy OutputPaths path, handing off to the
+// async writer when Config.Async is enabled so the caller's goroutine never
+// blocks on an io.Writer.
 func (l *Logger) writeLog(entry *logEntry) {
-	var output []byte
-	var err error
-
-	if l.config.Format == "json" {
-		output, err = json.Marshal(entry)
-	} else {
-		output = []byte(fmt.Sprintf("[%s] %s: %s (RequestID: %s)\n",
-			entry.Timestamp.Format(time.RFC3339),
-			entry.Level,
-			entry.Message,
-			entry.RequestID))
+	if len(l.sinks) > 0 {
+		for _, p := range l.sinks {
+			if p.enabled(entry.Level) {
+				p.dispatch(entry)
+			}
+		}
+		return
 	}
 
-	if err != nil {
+	if l.async != nil {
+		l.async.enqueue(entry)
 		return
 	}
+	l.writeLogSync(entry)
+}
 
+func (l *Logger) writeLogSync(entry *logEntry) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	if l.slogHandler != nil {
+		l.writeSlog(entry)
+		return
+	}
+
+	output, err := encodeEntry(entry, l.config.Format)
+	if err != nil {
+		return
+	}
+
 	for _, w := range l.writers {
 		w.Write(output)
-		if l.config.Format == "json" {
-			w.Write([]byte("\n"))
-		}
+	}
+}
+
+// writeSlog hands entry to the slog handler built for a "slog-json" or
+// "slog-text" format, preserving the request/trace IDs and fields that the
+// legacy encodings write out explicitly. Callers must hold l.mu.
+func (l *Logger) writeSlog(entry *logEntry) {
+	record := slog.NewRecord(entry.Timestamp, levelToSlogLevel(entry.Level), entry.Message, 0)
+	record.AddAttrs(
+		slog.String("service", entry.Service),
+		slog.String("environment", entry.Environment),
+	)
+	if entry.RequestID != "" {
+		record.AddAttrs(slog.String("request_id", entry.RequestID))
+	}
+	if entry.TraceID != "" {
+		record.AddAttrs(slog.String("trace_id", entry.TraceID))
+	}
+	if entry.SpanID != "" {
+		record.AddAttrs(slog.String("span_id", entry.SpanID))
+	}
+	for k, v := range entry.Fields {
+		record.AddAttrs(slog.Any(k, v))
+	}
+
+	if err := l.slogHandler.Handle(context.Background(), record); err != nil {
+		fmt.Fprintf(os.Stderr, "logger: slog handler error: %v\n", err)
 	}
 }
 
 // Logger interface implementation
-func (l *Logger) Debug(msg string, fields types.Fields) {
-	l.log(types.DebugLevel, msg, fields)
+func (l *Logger) Debug(ctx context.Context, msg string, fields types.Fields) {
+	l.log(ctx, types.DebugLevel, msg, fields)
 }
 
-func (l *Logger) Info(msg string, fields types.Fields) {
-	l.log(types.InfoLevel, msg, fields)
+func (l *Logger) Info(ctx context.Context, msg string, fields types.Fields) {
+	l.log(ctx, types.InfoLevel, msg, fields)
 }
 
-func (l *Logger) Warn(msg string, fields types.Fields) {
-	l.log(types.WarnLevel, msg, fields)
+func (l *Logger) Warn(ctx context.Context, msg string, fields types.Fields) {
+	l.log(ctx, types.WarnLevel, msg, fields)
 }
 
-func (l *Logger) Error(msg string, fields types.Fields) {
-	l.log(types.ErrorLevel, msg, fields)
+func (l *Logger) Error(ctx context.Context, msg string, fields types.Fields) {
+	l.log(ctx, types.ErrorLevel, msg, fields)
 }
 
-func (l *Logger) Fatal(msg string, fields types.Fields) {
-	l.log(types.FatalLevel, msg, fields)
+// fatalFlushTimeout bounds how long Fatal waits for a pending async write to
+// land before exiting, so a stuck sink can't turn a crash into a hang.
+const fatalFlushTimeout = 5 * time.Second
+
+func (l *Logger) Fatal(ctx context.Context, msg string, fields types.Fields) {
+	l.log(ctx, types.FatalLevel, msg, fields)
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), fatalFlushTimeout)
+	defer cancel()
+	l.Flush(flushCtx)
+
 	os.Exit(1)
 }
 
+// Stats reports the state of the async writer(s): the Config.Async writer and
+// every sink's own. When async writing is disabled everywhere, QueueDepth is 0
+// and DroppedByLevel is empty; FlushLatency is always the duration of the most
+// recent Flush call.
+func (l *Logger) Stats() types.Stats {
+	stats := types.Stats{DroppedByLevel: make(map[types.Level]uint64)}
+
+	addFrom := func(a *asyncWriter) {
+		if a == nil {
+			return
+		}
+		stats.QueueDepth += a.depth()
+		for level, count := range a.droppedByLevel() {
+			stats.DroppedByLevel[level] += count
+		}
+	}
+
+	addFrom(l.async)
+	for _, p := range l.sinks {
+		addFrom(p.async)
+	}
+
+	stats.FlushLatency = time.Duration(atomic.LoadInt64(&l.flushLatency))
+	return stats
+}
+
+// Flush blocks until every currently queued event has been written, or ctx
+// is done. It is a no-op when async writing is disabled everywhere.
+func (l *Logger) Flush(ctx context.Context) error {
+	start := time.Now()
+	defer func() { atomic.StoreInt64(&l.flushLatency, int64(time.Since(start))) }()
+
+	if l.async != nil {
+		if err := l.async.flush(ctx); err != nil {
+			return err
+		}
+	}
+	for _, p := range l.sinks {
+		if p.async == nil {
+			continue
+		}
+		if err := p.async.flush(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops every background writer, if any, after draining its queue,
+// closes the sinks themselves, and closes any files opened for OutputPaths.
+func (l *Logger) Close() error {
+	var firstErr error
+	note := func(err error) {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if l.async != nil {
+		note(l.async.close())
+	}
+	for _, p := range l.sinks {
+		if p.async != nil {
+			note(p.async.close())
+		}
+		note(p.sink.Close())
+	}
+	for _, c := range l.closers {
+		note(c.Close())
+	}
+
+	return firstErr
+}
+
 func (l *Logger) WithFields(fields types.Fields) types.Logger {
 	newLogger := &Logger{
 		config:        l.config,
 		writers:       l.writers,
+		closers:       l.closers,
 		defaultFields: make(types.Fields),
+		slogHandler:   l.slogHandler,
+		sampler:       l.sampler,
+		limiter:       l.limiter,
+		contexters:    l.contexters,
+		async:         l.async,
+		sinks:         l.sinks,
+		minLevel:      l.minLevel,
 	}
 
 	for k, v := range l.defaultFields {
@@ -194,3 +506,21 @@ func (l *Logger) WithFields(fields types.Fields) types.Logger {
 
 	return newLogger
 }
+
+func (l *Logger) WithContexter(fn types.Contexter) types.Logger {
+	newLogger := &Logger{
+		config:        l.config,
+		writers:       l.writers,
+		closers:       l.closers,
+		defaultFields: l.defaultFields,
+		slogHandler:   l.slogHandler,
+		sampler:       l.sampler,
+		limiter:       l.limiter,
+		contexters:    append(append([]types.Contexter{}, l.contexters...), fn),
+		async:         l.async,
+		sinks:         l.sinks,
+		minLevel:      l.minLevel,
+	}
+
+	return newLogger
+}
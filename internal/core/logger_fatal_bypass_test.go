@@ -0,0 +1,73 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/null-bd/logger/types"
+)
+
+// TestFatalBypassesRateLimit regression-tests that a RateLimitConfig
+// covering FatalLevel can't make log() silently drop a Fatal call: that
+// would kill the process via Fatal's os.Exit with zero trace of why.
+func TestFatalBypassesRateLimit(t *testing.T) {
+	limiter := newRateLimiter(types.RateLimitConfig{types.FatalLevel: 1})
+
+	// Confirm the limiter itself would reject a second same-second call, so
+	// the rest of this test is actually exercising the bypass and not a
+	// limiter that never would have rejected anything.
+	if !limiter.allow(types.FatalLevel) {
+		t.Fatal("test setup: expected the first rate-limit check to pass")
+	}
+	if limiter.allow(types.FatalLevel) {
+		t.Fatal("test setup: expected a second same-second check to fail")
+	}
+
+	var captured []*logEntry
+	l := &Logger{
+		config:        &types.Config{LogLevel: types.DebugLevel},
+		defaultFields: make(types.Fields),
+		limiter:       newRateLimiter(types.RateLimitConfig{types.FatalLevel: 1}),
+		async: newAsyncWriter(&types.AsyncConfig{Enabled: true, BufferSize: 8}, func(entry *logEntry) {
+			captured = append(captured, entry)
+		}),
+	}
+	defer l.async.close()
+
+	l.log(context.Background(), types.FatalLevel, "first", nil)
+	l.log(context.Background(), types.FatalLevel, "second", nil)
+
+	if err := l.Flush(context.Background()); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if len(captured) != 2 {
+		t.Fatalf("expected both Fatal calls to bypass the rate limiter and be written, got %d", len(captured))
+	}
+}
+
+// TestFatalBypassesSampling mirrors TestFatalBypassesRateLimit for sampling:
+// a SamplingConfig that would normally thin out a level must not apply to
+// Fatal either.
+func TestFatalBypassesSampling(t *testing.T) {
+	var captured []*logEntry
+	l := &Logger{
+		config:        &types.Config{LogLevel: types.DebugLevel},
+		defaultFields: make(types.Fields),
+		sampler:       newSampler(&types.SamplingConfig{Initial: 1, Thereafter: 1000}),
+		async: newAsyncWriter(&types.AsyncConfig{Enabled: true, BufferSize: 8}, func(entry *logEntry) {
+			captured = append(captured, entry)
+		}),
+	}
+	defer l.async.close()
+
+	for i := 0; i < 5; i++ {
+		l.log(context.Background(), types.FatalLevel, "dying", nil)
+	}
+
+	if err := l.Flush(context.Background()); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if len(captured) != 5 {
+		t.Fatalf("expected sampling to leave every Fatal call untouched, got %d of 5", len(captured))
+	}
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/null-bd/logger/types"
+)
+
+// TestFatalFlushesAsyncBeforeExit checks that Fatal's async-queued message
+// survives the process exit instead of being dropped mid-flight. Fatal calls
+// os.Exit, so the actual Fatal call is driven from a subprocess: this test
+// re-execs itself with TEST_FATAL_SUBPROCESS set, and the branch below does
+// the real work in that subprocess instead of recursing again.
+func TestFatalFlushesAsyncBeforeExit(t *testing.T) {
+	if os.Getenv("TEST_FATAL_SUBPROCESS") == "1" {
+		runFatalSubprocess()
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestFatalFlushesAsyncBeforeExit")
+	cmd.Env = append(os.Environ(), "TEST_FATAL_SUBPROCESS=1")
+	output, err := cmd.CombinedOutput()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("expected subprocess to exit with an error, got %v (output: %s)", err, output)
+	}
+	if exitErr.ExitCode() != 1 {
+		t.Fatalf("expected exit code 1, got %d", exitErr.ExitCode())
+	}
+	if !strings.Contains(string(output), "dying now") {
+		t.Fatalf("expected the fatal message to have been flushed before exit, got %q", output)
+	}
+}
+
+func runFatalSubprocess() {
+	l, err := NewLogger(&types.Config{
+		ServiceName: "test",
+		LogLevel:    types.DebugLevel,
+		Format:      "json",
+		OutputPaths: []string{"stdout"},
+		Async:       &types.AsyncConfig{Enabled: true, BufferSize: 8},
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	l.Fatal(context.Background(), "dying now", nil)
+}
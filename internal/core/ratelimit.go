@@ -0,0 +1,68 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+// rateLimiter enforces Config.RateLimit's per-level token buckets, refilled
+// continuously at the configured events-per-second rate.
+type rateLimiter struct {
+	rates types.RateLimitConfig
+
+	mu      sync.Mutex
+	buckets map[types.Level]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens   float64
+	lastFill time.Time
+}
+
+func newRateLimiter(cfg types.RateLimitConfig) *rateLimiter {
+	if len(cfg) == 0 {
+		return nil
+	}
+	return &rateLimiter{
+		rates:   cfg,
+		buckets: make(map[types.Level]*tokenBucket),
+	}
+}
+
+// allow reports whether an event at level should be emitted, consuming a
+// token if so. A nil rateLimiter, or a level absent from the config, is
+// always allowed.
+func (r *rateLimiter) allow(level types.Level) bool {
+	if r == nil {
+		return true
+	}
+
+	perSecond, limited := r.rates[level]
+	if !limited || perSecond <= 0 {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	b, ok := r.buckets[level]
+	if !ok {
+		b = &tokenBucket{tokens: float64(perSecond), lastFill: now}
+		r.buckets[level] = b
+	}
+
+	b.tokens += now.Sub(b.lastFill).Seconds() * float64(perSecond)
+	if b.tokens > float64(perSecond) {
+		b.tokens = float64(perSecond)
+	}
+	b.lastFill = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+// sampler implements zap-style per-level sampling: the first `initial`
+// events in a one-second window always pass, and thereafter only every
+// `thereafter`-th event does.
+type sampler struct {
+	initial    int
+	thereafter int
+
+	mu     sync.Mutex
+	counts map[types.Level]*sampleWindow
+}
+
+type sampleWindow struct {
+	resetAt time.Time
+	count   int
+}
+
+func newSampler(cfg *types.SamplingConfig) *sampler {
+	if cfg == nil || (cfg.Initial <= 0 && cfg.Thereafter <= 0) {
+		return nil
+	}
+	return &sampler{
+		initial:    cfg.Initial,
+		thereafter: cfg.Thereafter,
+		counts:     make(map[types.Level]*sampleWindow),
+	}
+}
+
+// allow reports whether an event at level should be emitted. A nil sampler
+// always allows, so callers don't need to special-case "sampling disabled".
+func (s *sampler) allow(level types.Level) bool {
+	if s == nil {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	w, ok := s.counts[level]
+	if !ok || !now.Before(w.resetAt) {
+		w = &sampleWindow{resetAt: now.Add(time.Second)}
+		s.counts[level] = w
+	}
+
+	w.count++
+	if w.count <= s.initial {
+		return true
+	}
+	if s.thereafter <= 0 {
+		return false
+	}
+	return (w.count-s.initial)%s.thereafter == 0
+}
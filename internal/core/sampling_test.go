@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/null-bd/logger/types"
+)
+
+func TestSamplerNilIsAlwaysAllowed(t *testing.T) {
+	var s *sampler
+	if !s.allow(types.InfoLevel) {
+		t.Fatal("a nil sampler must allow everything")
+	}
+}
+
+func TestSamplerInitialAndThereafter(t *testing.T) {
+	s := newSampler(&types.SamplingConfig{Initial: 2, Thereafter: 3})
+
+	var allowed int
+	for i := 0; i < 8; i++ {
+		if s.allow(types.InfoLevel) {
+			allowed++
+		}
+	}
+
+	// Events 1-2 pass (Initial), then only every 3rd thereafter: events
+	// 5 and 8 (3rd and 6th post-initial event) -> 4 allowed of 8.
+	if allowed != 4 {
+		t.Fatalf("expected 4 of 8 events to pass, got %d", allowed)
+	}
+}
+
+func TestSamplerTracksLevelsIndependently(t *testing.T) {
+	s := newSampler(&types.SamplingConfig{Initial: 1, Thereafter: 0})
+
+	if !s.allow(types.InfoLevel) {
+		t.Fatal("expected the first info event to pass")
+	}
+	if s.allow(types.InfoLevel) {
+		t.Fatal("expected the second info event to be dropped (thereafter disabled)")
+	}
+	if !s.allow(types.ErrorLevel) {
+		t.Fatal("expected the first error event to pass independently of info's window")
+	}
+}
+
+func TestRateLimiterNilIsAlwaysAllowed(t *testing.T) {
+	var r *rateLimiter
+	if !r.allow(types.InfoLevel) {
+		t.Fatal("a nil rateLimiter must allow everything")
+	}
+}
+
+func TestRateLimiterUnconfiguredLevelIsAlwaysAllowed(t *testing.T) {
+	r := newRateLimiter(types.RateLimitConfig{types.ErrorLevel: 1})
+	for i := 0; i < 5; i++ {
+		if !r.allow(types.InfoLevel) {
+			t.Fatalf("expected info (unconfigured) to always be allowed, failed on iteration %d", i)
+		}
+	}
+}
+
+func TestRateLimiterCapsPerSecond(t *testing.T) {
+	r := newRateLimiter(types.RateLimitConfig{types.ErrorLevel: 1})
+
+	if !r.allow(types.ErrorLevel) {
+		t.Fatal("expected the first event within the bucket to pass")
+	}
+	if r.allow(types.ErrorLevel) {
+		t.Fatal("expected a second immediate event to be rejected by the 1/sec bucket")
+	}
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/null-bd/logger/types"
+)
+
+// Sink is a single output in a Config.Sinks pipeline. Write receives both
+// the structured entry and its already-encoded payload, since a sink like
+// syslog or Loki needs the former (severity, labels) as well as the latter
+// (the line itself).
+type Sink interface {
+	Write(entry *logEntry, payload []byte) error
+	Close() error
+}
+
+// SinkFactory builds a Sink from its configuration. Register one with
+// RegisterSinkFactory to make Config.Sinks entries of a custom Type buildable.
+type SinkFactory func(cfg types.SinkConfig) (Sink, error)
+
+var sinkFactories = map[string]SinkFactory{
+	types.SinkTypeStdout: newStdoutSink,
+	types.SinkTypeStderr: newStderrSink,
+	types.SinkTypeFile:   newFileSink,
+	types.SinkTypeSyslog: newSyslogSink,
+	types.SinkTypeLoki:   newLokiSink,
+}
+
+// RegisterSinkFactory registers factory under name, so Config.Sinks entries
+// with that Type build sinks through it. Registering under an existing name
+// (including a built-in one) replaces it.
+func RegisterSinkFactory(name string, factory SinkFactory) {
+	sinkFactories[name] = factory
+}
+
+func buildSink(cfg types.SinkConfig) (Sink, error) {
+	factory, ok := sinkFactories[cfg.Type]
+	if !ok {
+		return nil, fmt.Errorf("no sink factory registered for type %q", cfg.Type)
+	}
+	return factory(cfg)
+}
+
+// writerSink adapts a plain io.Writer (stdout, stderr) into a Sink that
+// ignores the structured entry and just writes the payload. It serializes
+// writes the same way fileSink and syslogSink do, since a single Write on
+// the underlying io.Writer isn't guaranteed atomic against concurrent
+// callers.
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *writerSink) Write(_ *logEntry, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.w.Write(payload)
+	return err
+}
+
+func (*writerSink) Close() error { return nil }
+
+func newStdoutSink(types.SinkConfig) (Sink, error) {
+	return &writerSink{w: os.Stdout}, nil
+}
+
+func newStderrSink(types.SinkConfig) (Sink, error) {
+	return &writerSink{w: os.Stderr}, nil
+}
@@ -0,0 +1,159 @@
+package core
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+// fileSink writes to a file, rotating it the way lumberjack does: once it
+// passes MaxSizeMB, the current file is renamed aside (optionally gzipped),
+// and backups beyond MaxAgeDays or MaxBackups are pruned.
+type fileSink struct {
+	cfg types.FileSinkConfig
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+func newFileSink(cfg types.SinkConfig) (Sink, error) {
+	if cfg.File == nil || cfg.File.Path == "" {
+		return nil, fmt.Errorf("file sink requires file.path")
+	}
+
+	s := &fileSink{cfg: *cfg.File}
+	if err := s.openCurrent(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileSink) openCurrent() error {
+	f, err := os.OpenFile(s.cfg.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *fileSink) Write(_ *logEntry, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cfg.MaxSizeMB > 0 && s.size+int64(len(payload)) > int64(s.cfg.MaxSizeMB)*1024*1024 {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(payload)
+	s.size += int64(n)
+	return err
+}
+
+func (s *fileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	rotated := fmt.Sprintf("%s.%s", s.cfg.Path, time.Now().UTC().Format("20060102T150405.000000000"))
+	if err := os.Rename(s.cfg.Path, rotated); err != nil {
+		return err
+	}
+
+	if s.cfg.Compress {
+		if err := gzipFile(rotated); err == nil {
+			os.Remove(rotated)
+		}
+	}
+
+	s.pruneBackups()
+
+	return s.openCurrent()
+}
+
+func (s *fileSink) pruneBackups() {
+	dir := filepath.Dir(s.cfg.Path)
+	base := filepath.Base(s.cfg.Path)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	var backups []string
+	for _, e := range entries {
+		name := e.Name()
+		if name == base || !strings.HasPrefix(name, base+".") {
+			continue
+		}
+		backups = append(backups, filepath.Join(dir, name))
+	}
+	// The timestamp suffix sorts chronologically, oldest first.
+	sort.Strings(backups)
+
+	if s.cfg.MaxAgeDays > 0 {
+		cutoff := time.Now().Add(-time.Duration(s.cfg.MaxAgeDays) * 24 * time.Hour)
+		kept := backups[:0]
+		for _, b := range backups {
+			info, err := os.Stat(b)
+			if err != nil || info.ModTime().Before(cutoff) {
+				os.Remove(b)
+				continue
+			}
+			kept = append(kept, b)
+		}
+		backups = kept
+	}
+
+	if s.cfg.MaxBackups > 0 && len(backups) > s.cfg.MaxBackups {
+		for _, b := range backups[:len(backups)-s.cfg.MaxBackups] {
+			os.Remove(b)
+		}
+	}
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+func gzipFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(path + ".gz")
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	gw := gzip.NewWriter(dst)
+	if _, err := io.Copy(gw, src); err != nil {
+		gw.Close()
+		return err
+	}
+	return gw.Close()
+}
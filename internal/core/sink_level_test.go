@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/null-bd/logger/types"
+)
+
+// captureSink is a test-only Sink that just records the entries it receives.
+type captureSink struct {
+	entries []*logEntry
+}
+
+func (s *captureSink) Write(entry *logEntry, _ []byte) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *captureSink) Close() error { return nil }
+
+// TestSinkMinLevelCanLowerTheGlobalFloor regression-tests that a sink's
+// MinLevel can admit levels below Config.LogLevel: the textbook use case is
+// a debug-level file sink alongside an info-level stdout sink, which
+// requires the pre-dispatch level gate to key off the lowest configured
+// floor, not Config.LogLevel alone.
+func TestSinkMinLevelCanLowerTheGlobalFloor(t *testing.T) {
+	debugSink := &captureSink{}
+	infoSink := &captureSink{}
+
+	RegisterSinkFactory("test-capture-debug", func(types.SinkConfig) (Sink, error) { return debugSink, nil })
+	RegisterSinkFactory("test-capture-info", func(types.SinkConfig) (Sink, error) { return infoSink, nil })
+
+	l, err := NewLogger(&types.Config{
+		LogLevel: types.InfoLevel,
+		Format:   "json",
+		Sinks: []types.SinkConfig{
+			{Type: "test-capture-debug", MinLevel: types.DebugLevel},
+			{Type: "test-capture-info", MinLevel: types.InfoLevel},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewLogger: %v", err)
+	}
+
+	l.Debug(context.Background(), "debug message", nil)
+
+	if len(debugSink.entries) != 1 {
+		t.Fatalf("expected the debug-level sink to receive the Debug call despite Config.LogLevel=info, got %d entries", len(debugSink.entries))
+	}
+	if len(infoSink.entries) != 0 {
+		t.Fatalf("expected the info-level sink to still filter out Debug, got %d entries", len(infoSink.entries))
+	}
+}
@@ -0,0 +1,219 @@
+package core
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+const (
+	defaultLokiBatchSize     = 100
+	defaultLokiBatchInterval = 2 * time.Second
+)
+
+// lokiSink batches entries and pushes them to Loki's HTTP push API
+// (POST <url> with a {"streams": [...]} body), gzip-compressing the body
+// and attaching a tenant header when configured.
+type lokiSink struct {
+	cfg    types.LokiSinkConfig
+	client *http.Client
+
+	mu    sync.Mutex
+	batch []lokiLine
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+type lokiLine struct {
+	labels map[string]string
+	ts     time.Time
+	line   string
+}
+
+func newLokiSink(cfg types.SinkConfig) (Sink, error) {
+	if cfg.Loki == nil || cfg.Loki.URL == "" {
+		return nil, fmt.Errorf("loki sink requires loki.url")
+	}
+
+	lc := *cfg.Loki
+	if lc.BatchSize <= 0 {
+		lc.BatchSize = defaultLokiBatchSize
+	}
+	if lc.BatchInterval <= 0 {
+		lc.BatchInterval = defaultLokiBatchInterval
+	}
+
+	s := &lokiSink{
+		cfg:    lc,
+		client: &http.Client{Timeout: 10 * time.Second},
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go s.runBatcher()
+	return s, nil
+}
+
+func (s *lokiSink) runBatcher() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.cfg.BatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.flush()
+		case <-s.stop:
+			s.flush()
+			return
+		}
+	}
+}
+
+func (s *lokiSink) Write(entry *logEntry, payload []byte) error {
+	labels := make(map[string]string, len(s.cfg.Labels)+len(s.cfg.LabelFields)+2)
+	for k, v := range s.cfg.Labels {
+		labels[k] = v
+	}
+	for _, field := range s.cfg.LabelFields {
+		if v, ok := entry.Fields[field]; ok {
+			labels[field] = fmt.Sprintf("%v", v)
+		}
+	}
+	labels["level"] = string(entry.Level)
+	labels["service"] = entry.Service
+
+	s.mu.Lock()
+	s.batch = append(s.batch, lokiLine{labels: labels, ts: entry.Timestamp, line: string(payload)})
+	full := len(s.batch) >= s.cfg.BatchSize
+	s.mu.Unlock()
+
+	if full {
+		s.flush()
+	}
+	return nil
+}
+
+func (s *lokiSink) flush() {
+	s.mu.Lock()
+	batch := s.batch
+	s.batch = nil
+	s.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := s.push(batch); err != nil {
+		fmt.Fprintf(os.Stderr, "logger: loki push error: %v\n", err)
+	}
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (s *lokiSink) push(batch []lokiLine) error {
+	streams := make(map[string]*lokiStream)
+	order := make([]string, 0)
+
+	for _, line := range batch {
+		key := lokiLabelsKey(line.labels)
+		st, ok := streams[key]
+		if !ok {
+			st = &lokiStream{Stream: line.labels}
+			streams[key] = st
+			order = append(order, key)
+		}
+		st.Values = append(st.Values, [2]string{fmt.Sprintf("%d", line.ts.UnixNano()), line.line})
+	}
+
+	req := lokiPushRequest{Streams: make([]lokiStream, 0, len(order))}
+	for _, key := range order {
+		req.Streams = append(req.Streams, *streams[key])
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader = bytes.NewReader(body)
+	encoding := ""
+	if s.cfg.Gzip {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		if _, err := gw.Write(body); err != nil {
+			return err
+		}
+		if err := gw.Close(); err != nil {
+			return err
+		}
+		reader = &buf
+		encoding = "gzip"
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, s.cfg.URL, reader)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if encoding != "" {
+		httpReq.Header.Set("Content-Encoding", encoding)
+	}
+	if s.cfg.TenantID != "" {
+		httpReq.Header.Set("X-Scope-OrgID", s.cfg.TenantID)
+	}
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("loki push returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// lokiLabelsKey builds a stable key for grouping lines sharing the same
+// label set into one stream, as Loki's push API requires.
+func lokiLabelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(labels[k])
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+func (s *lokiSink) Close() error {
+	close(s.stop)
+	<-s.done
+	return nil
+}
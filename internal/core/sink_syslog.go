@@ -0,0 +1,99 @@
+package core
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/null-bd/logger/types"
+)
+
+// syslogSink writes RFC 5424 formatted messages to a syslog daemon.
+type syslogSink struct {
+	cfg types.SyslogSinkConfig
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+var syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5,
+	"lpr": 6, "news": 7, "uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+var syslogSeverities = map[types.Level]int{
+	types.DebugLevel: 7,
+	types.InfoLevel:  6,
+	types.WarnLevel:  4,
+	types.ErrorLevel: 3,
+	types.FatalLevel: 2,
+}
+
+func newSyslogSink(cfg types.SinkConfig) (Sink, error) {
+	if cfg.Syslog == nil {
+		return nil, fmt.Errorf("syslog sink requires syslog config")
+	}
+
+	sc := *cfg.Syslog
+	if sc.Network == "" {
+		sc.Network = "unixgram"
+	}
+
+	address := sc.Address
+	if address == "" {
+		address = "/dev/log"
+	}
+
+	conn, err := net.Dial(sc.Network, address)
+	if err != nil {
+		return nil, fmt.Errorf("dialing syslog: %w", err)
+	}
+
+	return &syslogSink{cfg: sc, conn: conn}, nil
+}
+
+func (s *syslogSink) Write(entry *logEntry, payload []byte) error {
+	facility, ok := syslogFacilities[s.cfg.Facility]
+	if !ok {
+		facility = syslogFacilities["local0"]
+	}
+
+	severity, ok := syslogSeverities[entry.Level]
+	if !ok {
+		severity = syslogSeverities[types.InfoLevel]
+	}
+
+	tag := s.cfg.Tag
+	if tag == "" {
+		tag = "logger"
+	}
+
+	// RFC 5424: <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID [SD] MSG
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "-"
+	}
+
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d - - %s",
+		facility*8+severity,
+		entry.Timestamp.UTC().Format(time.RFC3339Nano),
+		hostname,
+		tag,
+		os.Getpid(),
+		payload)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.conn.Write([]byte(msg))
+	return err
+}
+
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.Close()
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowWriter splits a Write into two parts with a sleep in between, so an
+// unsynchronized caller's concurrent Write would observe (or produce)
+// interleaved output.
+type slowWriter struct {
+	mu  sync.Mutex
+	buf []byte
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	if len(p) > 1 {
+		mid := len(p) / 2
+		w.append(p[:mid])
+		time.Sleep(2 * time.Millisecond)
+		w.append(p[mid:])
+		return len(p), nil
+	}
+	w.append(p)
+	return len(p), nil
+}
+
+func (w *slowWriter) append(p []byte) {
+	w.mu.Lock()
+	w.buf = append(w.buf, p...)
+	w.mu.Unlock()
+}
+
+// TestWriterSinkSerializesConcurrentWrites regression-tests that writerSink
+// (the Sink backing the stdout/stderr sink types) guards its writes the same
+// way fileSink and syslogSink already do, so concurrent log calls through it
+// can't interleave.
+func TestWriterSinkSerializesConcurrentWrites(t *testing.T) {
+	w := &slowWriter{}
+	s := &writerSink{w: w}
+
+	const callers = 8
+	payload := []byte("0123456789\n")
+
+	var wg sync.WaitGroup
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer wg.Done()
+			if err := s.Write(nil, payload); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := len(w.buf), callers*len(payload); got != want {
+		t.Fatalf("expected %d bytes of non-interleaved output, got %d", want, got)
+	}
+	for i := 0; i < callers; i++ {
+		chunk := w.buf[i*len(payload) : (i+1)*len(payload)]
+		if string(chunk) != string(payload) {
+			t.Fatalf("writes interleaved: chunk %d was %q, want %q", i, chunk, payload)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/null-bd/logger/types"
+)
+
+// slogHandlerAdapter implements slog.Handler by forwarding records to a
+// types.Logger, so callers that already speak log/slog (as Prometheus,
+// Teleport, and others have migrated to) can plug this module in without
+// losing structured fields.
+type slogHandlerAdapter struct {
+	logger types.Logger
+	group  string
+	attrs  types.Fields
+}
+
+// NewSlogHandler adapts an existing Logger into a slog.Handler.
+func NewSlogHandler(logger types.Logger) slog.Handler {
+	return &slogHandlerAdapter{logger: logger, attrs: make(types.Fields)}
+}
+
+func (h *slogHandlerAdapter) Enabled(context.Context, slog.Level) bool {
+	// Level filtering is left to the wrapped Logger's own Config.LogLevel.
+	return true
+}
+
+func (h *slogHandlerAdapter) Handle(ctx context.Context, record slog.Record) error {
+	fields := make(types.Fields, len(h.attrs)+record.NumAttrs())
+	for k, v := range h.attrs {
+		fields[k] = v
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		flattenAttr(h.group, attr, fields)
+		return true
+	})
+
+	switch level := record.Level; {
+	case level < slog.LevelInfo:
+		h.logger.Debug(ctx, record.Message, fields)
+	case level < slog.LevelWarn:
+		h.logger.Info(ctx, record.Message, fields)
+	case level < slog.LevelError:
+		h.logger.Warn(ctx, record.Message, fields)
+	default:
+		h.logger.Error(ctx, record.Message, fields)
+	}
+
+	return nil
+}
+
+func (h *slogHandlerAdapter) WithAttrs(attrs []slog.Attr) slog.Handler {
+	merged := make(types.Fields, len(h.attrs)+len(attrs))
+	for k, v := range h.attrs {
+		merged[k] = v
+	}
+	for _, attr := range attrs {
+		flattenAttr(h.group, attr, merged)
+	}
+	return &slogHandlerAdapter{logger: h.logger, group: h.group, attrs: merged}
+}
+
+func (h *slogHandlerAdapter) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	group := name
+	if h.group != "" {
+		group = h.group + "." + name
+	}
+	return &slogHandlerAdapter{logger: h.logger, group: group, attrs: h.attrs}
+}
+
+// flattenAttr writes attr into dest, dot-joining nested groups onto prefix so
+// that slog's group nesting survives translation into types.Fields.
+func flattenAttr(prefix string, attr slog.Attr, dest types.Fields) {
+	value := attr.Value.Resolve()
+	key := attr.Key
+	if prefix != "" {
+		key = prefix + "." + key
+	}
+
+	if value.Kind() == slog.KindGroup {
+		for _, sub := range value.Group() {
+			flattenAttr(key, sub, dest)
+		}
+		return
+	}
+
+	dest[key] = value.Any()
+}
+
+// levelToSlogLevel maps our Level onto the closest slog.Level, matching
+// slog's own debug/info/warn/error tiers (fatal collapses into error, since
+// slog has no concept of a fatal severity).
+func levelToSlogLevel(level types.Level) slog.Level {
+	switch level {
+	case types.DebugLevel:
+		return slog.LevelDebug
+	case types.InfoLevel:
+		return slog.LevelInfo
+	case types.WarnLevel:
+		return slog.LevelWarn
+	case types.ErrorLevel, types.FatalLevel:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
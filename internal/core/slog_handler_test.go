@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/null-bd/logger/types"
+)
+
+// stubLogger is a minimal types.Logger that just records each call made to
+// it, so slogHandlerAdapter's level mapping and field flattening can be
+// asserted without a real backend.
+type stubLogger struct {
+	calls []stubCall
+}
+
+type stubCall struct {
+	level  string
+	msg    string
+	fields types.Fields
+}
+
+func (s *stubLogger) Debug(_ context.Context, msg string, fields types.Fields) {
+	s.calls = append(s.calls, stubCall{"debug", msg, fields})
+}
+func (s *stubLogger) Info(_ context.Context, msg string, fields types.Fields) {
+	s.calls = append(s.calls, stubCall{"info", msg, fields})
+}
+func (s *stubLogger) Warn(_ context.Context, msg string, fields types.Fields) {
+	s.calls = append(s.calls, stubCall{"warn", msg, fields})
+}
+func (s *stubLogger) Error(_ context.Context, msg string, fields types.Fields) {
+	s.calls = append(s.calls, stubCall{"error", msg, fields})
+}
+func (s *stubLogger) Fatal(_ context.Context, msg string, fields types.Fields) {
+	s.calls = append(s.calls, stubCall{"fatal", msg, fields})
+}
+func (s *stubLogger) WithFields(types.Fields) types.Logger       { return s }
+func (s *stubLogger) WithContexter(types.Contexter) types.Logger { return s }
+func (s *stubLogger) Stats() types.Stats                         { return types.Stats{} }
+func (s *stubLogger) Flush(context.Context) error                { return nil }
+func (s *stubLogger) Close() error                               { return nil }
+
+func TestSlogHandlerMapsLevels(t *testing.T) {
+	stub := &stubLogger{}
+	h := NewSlogHandler(stub)
+
+	logger := slog.New(h)
+	logger.Debug("a debug message")
+	logger.Info("an info message")
+	logger.Warn("a warn message")
+	logger.Error("an error message")
+
+	if len(stub.calls) != 4 {
+		t.Fatalf("expected 4 calls, got %d", len(stub.calls))
+	}
+	want := []string{"debug", "info", "warn", "error"}
+	for i, w := range want {
+		if stub.calls[i].level != w {
+			t.Errorf("call %d: expected level %q, got %q", i, w, stub.calls[i].level)
+		}
+	}
+}
+
+func TestSlogHandlerFlattensGroupsAndAttrs(t *testing.T) {
+	stub := &stubLogger{}
+	h := NewSlogHandler(stub).WithAttrs([]slog.Attr{slog.String("service", "api")}).WithGroup("http")
+
+	slog.New(h).Info("request handled", slog.Int("status", 200), slog.Group("client", slog.String("ip", "1.2.3.4")))
+
+	if len(stub.calls) != 1 {
+		t.Fatalf("expected 1 call, got %d", len(stub.calls))
+	}
+	fields := stub.calls[0].fields
+
+	if fields["service"] != "api" {
+		t.Errorf("expected WithAttrs field to survive, got %v", fields["service"])
+	}
+	if fields["http.status"] != int64(200) {
+		t.Errorf("expected group-prefixed int attr http.status=200, got %v", fields["http.status"])
+	}
+	if fields["http.client.ip"] != "1.2.3.4" {
+		t.Errorf("expected nested group http.client.ip=1.2.3.4, got %v", fields["http.client.ip"])
+	}
+}
@@ -1,16 +1,54 @@
 package logger
 
 import (
+	"log/slog"
+
 	"github.com/null-bd/logger/internal/core"
 	"github.com/null-bd/logger/types"
 )
 
 // Expose types from types package
 type (
-	Fields = types.Fields
-	Level  = types.Level
-	Logger = types.Logger
-	Config = types.Config
+	Fields           = types.Fields
+	Level            = types.Level
+	Logger           = types.Logger
+	Config           = types.Config
+	Contexter        = types.Contexter
+	SamplingConfig   = types.SamplingConfig
+	RateLimitConfig  = types.RateLimitConfig
+	AsyncConfig      = types.AsyncConfig
+	OverflowPolicy   = types.OverflowPolicy
+	Stats            = types.Stats
+	SinkConfig       = types.SinkConfig
+	FileSinkConfig   = types.FileSinkConfig
+	SyslogSinkConfig = types.SyslogSinkConfig
+	LokiSinkConfig   = types.LokiSinkConfig
+	Entry            = types.Entry
+	Formatter        = types.Formatter
+)
+
+// Sink type identifiers recognized by the built-in SinkFactory registry.
+const (
+	SinkTypeStdout = types.SinkTypeStdout
+	SinkTypeStderr = types.SinkTypeStderr
+	SinkTypeFile   = types.SinkTypeFile
+	SinkTypeSyslog = types.SinkTypeSyslog
+	SinkTypeLoki   = types.SinkTypeLoki
+)
+
+// RegisterSinkFactory registers a custom Sink builder under name, so
+// Config.Sinks entries with that Type build through it.
+var RegisterSinkFactory = core.RegisterSinkFactory
+
+// RegisterFormatter registers a custom Formatter under name, so Config.Format
+// or SinkConfig.Format values of name encode through it.
+var RegisterFormatter = core.RegisterFormatter
+
+// Expose overflow policy constants
+const (
+	OverflowBlock      = types.OverflowBlock
+	OverflowDropNewest = types.OverflowDropNewest
+	OverflowDropOldest = types.OverflowDropOldest
 )
 
 // Expose constants
@@ -23,13 +61,32 @@ const (
 )
 
 // Expose trace functions
+//
+// Deprecated: SetTraceFields, GetTraceFields, and ClearTraceFields key off a
+// goroutine ID and lose fields across goroutine boundaries. Use WithFields
+// and FieldsFromContext instead.
 var (
 	SetTraceFields   = core.SetTraceFields
 	GetTraceFields   = core.GetTraceFields
 	ClearTraceFields = core.ClearTraceFields
 )
 
+// WithFields returns a context carrying fields, merged on top of any fields
+// already attached by a previous WithFields call. Logger.Debug/Info/...
+// read these back out automatically via the ctx they're passed.
+var WithFields = core.WithFields
+
+// FieldsFromContext returns the fields attached to ctx by WithFields, or nil
+// if none have been attached.
+var FieldsFromContext = core.FieldsFromContext
+
 // New creates a new logger instance with the provided configuration
 func New(cfg *Config) (Logger, error) {
 	return core.NewLogger(cfg)
 }
+
+// NewSlogHandler adapts a Logger into a slog.Handler, so it can be plugged in
+// anywhere a log/slog handler is expected (e.g. slog.New(logger.NewSlogHandler(l))).
+func NewSlogHandler(l Logger) slog.Handler {
+	return core.NewSlogHandler(l)
+}
@@ -1,6 +1,9 @@
 package types
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Level represents log severity
 type Level string
@@ -24,8 +27,86 @@ type Logger interface {
 	Error(ctx context.Context, msg string, fields Fields)
 	Fatal(ctx context.Context, msg string, fields Fields)
 	WithFields(fields Fields) Logger
+	// WithContexter returns a Logger that also merges in fields derived from
+	// fn on every event that survives level, sampling, and rate-limit checks.
+	WithContexter(fn Contexter) Logger
+
+	// Stats reports the state of the asynchronous writer(s) enabled by
+	// Config.Async or a SinkConfig's Async. When none is enabled, QueueDepth
+	// is 0 and DroppedByLevel is empty.
+	Stats() Stats
+	// Flush blocks until every currently queued event has been written, or
+	// ctx is done. It is a no-op when neither Config.Async nor any
+	// SinkConfig's Async is enabled.
+	Flush(ctx context.Context) error
+	// Close stops the background writer, if any, after draining its queue.
+	Close() error
+}
+
+// OverflowPolicy governs what happens when the async writer's buffer is full.
+type OverflowPolicy string
+
+const (
+	OverflowBlock      OverflowPolicy = "block"
+	OverflowDropNewest OverflowPolicy = "drop_newest"
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+)
+
+// AsyncConfig enables a bounded background writer so Debug/Info/... never
+// block on the underlying io.Writer(s) in the caller's hot path.
+type AsyncConfig struct {
+	Enabled        bool           `json:"enabled" yaml:"enabled"`
+	BufferSize     int            `json:"buffer_size" yaml:"buffer_size"`
+	OverflowPolicy OverflowPolicy `json:"overflow_policy" yaml:"overflow_policy"`
 }
 
+// Stats reports asynchronous writer health for monitoring and alerting.
+type Stats struct {
+	QueueDepth     int
+	DroppedByLevel map[Level]uint64
+	FlushLatency   time.Duration
+}
+
+// Entry is the structured representation of one log event, passed to a
+// Formatter for encoding.
+type Entry struct {
+	Timestamp   time.Time `json:"timestamp"`
+	Level       Level     `json:"level"`
+	Message     string    `json:"message"`
+	Service     string    `json:"service"`
+	Environment string    `json:"environment"`
+	RequestID   string    `json:"request_id,omitempty"`
+	TraceID     string    `json:"trace_id,omitempty"`
+	SpanID      string    `json:"span_id,omitempty"`
+	Fields      Fields    `json:"fields,omitempty"`
+}
+
+// Formatter encodes an Entry into its wire representation. Register custom
+// formatters with RegisterFormatter to make them selectable by name via
+// Config.Format or SinkConfig.Format.
+type Formatter interface {
+	Format(entry *Entry) ([]byte, error)
+}
+
+// Contexter derives additional fields from a request context. It is only
+// invoked for events that will actually be emitted, so per-request enrichers
+// that are expensive to compute don't run on filtered-out logs.
+type Contexter func(context.Context) Fields
+
+// SamplingConfig thins out log volume the way zap's sampler does: the first
+// Initial events per second at a given level always pass through, and
+// thereafter only every Thereafter-th event does. A zero value disables
+// sampling.
+type SamplingConfig struct {
+	Initial    int `json:"initial" yaml:"initial"`
+	Thereafter int `json:"thereafter" yaml:"thereafter"`
+}
+
+// RateLimitConfig caps sustained log volume per level via a token bucket
+// refilled at the given number of events per second. Levels absent from the
+// map are unlimited.
+type RateLimitConfig map[Level]int
+
 // Config holds the logger configuration
 type Config struct {
 	ServiceName   string            `json:"service_name" yaml:"service_name"`
@@ -33,5 +114,69 @@ type Config struct {
 	LogLevel      Level             `json:"log_level" yaml:"log_level"`
 	Format        string            `json:"format" yaml:"format"`
 	DefaultFields map[string]string `json:"default_fields" yaml:"default_fields"`
-	OutputPaths   []string          `json:"output_paths" yaml:"output_paths"`
+	// OutputPaths is a plain fan-out of writer destinations ("stdout",
+	// "stderr", or a file path).
+	//
+	// Deprecated: use Sinks, which supports per-sink level/format/async
+	// settings plus file rotation, syslog, and Loki outputs.
+	OutputPaths []string        `json:"output_paths" yaml:"output_paths"`
+	Sampling    *SamplingConfig `json:"sampling,omitempty" yaml:"sampling,omitempty"`
+	RateLimit   RateLimitConfig `json:"rate_limit,omitempty" yaml:"rate_limit,omitempty"`
+	Async       *AsyncConfig    `json:"async,omitempty" yaml:"async,omitempty"`
+	Sinks       []SinkConfig    `json:"sinks,omitempty" yaml:"sinks,omitempty"`
+}
+
+// Sink types recognized by the built-in SinkFactory registry.
+const (
+	SinkTypeStdout = "stdout"
+	SinkTypeStderr = "stderr"
+	SinkTypeFile   = "file"
+	SinkTypeSyslog = "syslog"
+	SinkTypeLoki   = "loki"
+)
+
+// SinkConfig configures one output in a Config.Sinks pipeline. Type selects
+// the SinkFactory that builds it; exactly one of File, Syslog, or Loki
+// should be set for the corresponding Type.
+type SinkConfig struct {
+	Type     string            `json:"type" yaml:"type"`
+	MinLevel Level             `json:"min_level,omitempty" yaml:"min_level,omitempty"`
+	Format   string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Async    *AsyncConfig      `json:"async,omitempty" yaml:"async,omitempty"`
+	File     *FileSinkConfig   `json:"file,omitempty" yaml:"file,omitempty"`
+	Syslog   *SyslogSinkConfig `json:"syslog,omitempty" yaml:"syslog,omitempty"`
+	Loki     *LokiSinkConfig   `json:"loki,omitempty" yaml:"loki,omitempty"`
+}
+
+// FileSinkConfig rotates its output the way lumberjack does: by size,
+// optionally pruning backups by age or count and gzip-compressing them.
+type FileSinkConfig struct {
+	Path       string `json:"path" yaml:"path"`
+	MaxSizeMB  int    `json:"max_size_mb,omitempty" yaml:"max_size_mb,omitempty"`
+	MaxAgeDays int    `json:"max_age_days,omitempty" yaml:"max_age_days,omitempty"`
+	MaxBackups int    `json:"max_backups,omitempty" yaml:"max_backups,omitempty"`
+	Compress   bool   `json:"compress,omitempty" yaml:"compress,omitempty"`
+}
+
+// SyslogSinkConfig pushes RFC 5424 formatted messages to a syslog daemon.
+type SyslogSinkConfig struct {
+	// Network is passed to net.Dial: "udp", "tcp", or "unixgram" for the
+	// local syslog socket (the default).
+	Network  string `json:"network,omitempty" yaml:"network,omitempty"`
+	Address  string `json:"address,omitempty" yaml:"address,omitempty"`
+	Tag      string `json:"tag,omitempty" yaml:"tag,omitempty"`
+	Facility string `json:"facility,omitempty" yaml:"facility,omitempty"`
+}
+
+// LokiSinkConfig batches entries and pushes them to a Loki HTTP push
+// endpoint. LabelFields copies the named Fields keys onto each stream's
+// labels in addition to the static Labels map.
+type LokiSinkConfig struct {
+	URL           string            `json:"url" yaml:"url"`
+	TenantID      string            `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	LabelFields   []string          `json:"label_fields,omitempty" yaml:"label_fields,omitempty"`
+	BatchSize     int               `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
+	BatchInterval time.Duration     `json:"batch_interval,omitempty" yaml:"batch_interval,omitempty"`
+	Gzip          bool              `json:"gzip,omitempty" yaml:"gzip,omitempty"`
 }